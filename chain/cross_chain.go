@@ -303,6 +303,19 @@ func (cch *CrossChainHelper) GetApiBridgeFromMainChain() bridge.APIBridge {
 	return &MainChainAPIBridge{ethereum}
 }
 
+// GetChildChainState returns the current state of the child chain, which is only known when this node runs it
+func (cch *CrossChainHelper) GetChildChainState(chainId string) (*state.StateDB, error) {
+	chain, ok := chainMgr.childChains[chainId]
+	if !ok || chain.EthNode == nil {
+		return nil, fmt.Errorf("child chain %s is not running on this node", chainId)
+	}
+	ethereum, err := getEthereumFromNode(chain.EthNode)
+	if err != nil {
+		return nil, err
+	}
+	return ethereum.BlockChain().State()
+}
+
 func (cch *CrossChainHelper) ChangeValidators(chainId string) {
 
 	if chainMgr == nil {
@@ -427,6 +440,7 @@ func (cch *CrossChainHelper) SaveChildChainProofDataToMainChain(proofData *types
 	if chainId == "" || chainId == MainChain || chainId == TestnetChain {
 		return fmt.Errorf("invalid child chain id: %s", chainId)
 	}
+	core.SaveChildChainReport(cch.chainInfoDB, chainId, tdmExtra.Height, header.Time)
 
 	// here is epoch update; should be a more general mechanism
 	if len(tdmExtra.EpochBytes) != 0 {
@@ -752,6 +766,7 @@ func (cch *CrossChainHelper) SaveChildChainProofDataToMainChainV1(proofData *typ
 	if chainId == "" || chainId == MainChain || chainId == TestnetChain {
 		return fmt.Errorf("invalid child chain id: %s", chainId)
 	}
+	core.SaveChildChainReport(cch.chainInfoDB, chainId, tdmExtra.Height, header.Time)
 
 	// here is epoch update; should be a more general mechanism
 	if len(tdmExtra.EpochBytes) != 0 {
@@ -805,6 +820,10 @@ func (cch *CrossChainHelper) DeleteTX3(chainId string, txHash common.Hash) {
 	rawdb.DeleteTX3(cch.localTX3CacheDB, chainId, txHash)
 }
 
+func (cch *CrossChainHelper) ForEachTX3(chainId string, cb func(tx *types.Transaction, blockNumber uint64) bool) {
+	rawdb.ForEachTX3(cch.localTX3CacheDB, chainId, cb)
+}
+
 func (cch *CrossChainHelper) WriteTX3ProofData(proofData *types.TX3ProofData) error {
 
 	header := proofData.Header